@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+// Copyright (c) 2022 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+// Copyright (C) 2023 Intel Corporation
+
+// Package frontend implememnts the FrontEnd APIs (host facing) of the storage Server
+package frontend
+
+import (
+	"context"
+	"log"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-nvidia-bridge/pkg/models"
+
+	"github.com/philippgille/gokv"
+)
+
+// namespaceIndexKey is the well-known store key holding the list of all
+// NvmeNamespace names known to this bridge, so they can be enumerated back
+// out of a gokv.Store that has no native key iteration
+const namespaceIndexKey = "opi-nvidia-bridge:namespace-index"
+
+// rpc is the narrow interface this package needs from the NVIDIA SPDK JSON-RPC client
+type rpc interface {
+	Call(ctx context.Context, method string, params, result any) error
+}
+
+// Server represents the Storage Server object
+type Server struct {
+	pb.UnimplementedFrontEndNvmeServiceServer
+
+	rpc   rpc
+	store gokv.Store
+
+	Subsystems  map[string]*pb.NvmeSubsystem
+	Controllers map[string]*pb.NvmeController
+	Namespaces  map[string]*pb.NvmeNamespace
+	Pagination  map[string]int
+
+	// RemoteControllers holds the outbound NVMe-oF controllers this bridge has connected to
+	// as an initiator (via bdev_nvme_attach_controller), keyed by their resource name. This is
+	// a distinct domain from Controllers, which holds host-facing controllers exposed under a
+	// local NvmeSubsystem.
+	RemoteControllers map[string]*pb.NvmeController
+
+	// statsCache holds the last-observed cumulative iostat counters per namespace,
+	// so NvmeNamespaceStats can report per-interval rates instead of raw cumulative counters
+	statsCache map[string]nvmeNamespaceStatsSample
+}
+
+// NewServer creates an initialized instance of Server, restoring any Namespaces
+// previously persisted in store. Call Reconcile once Subsystems and Controllers
+// have been bootstrapped (e.g. after their own CreateNvmeSubsystem/CreateNvmeController
+// state has been replayed) to cross-check the restored Namespaces against the live SPDK state
+func NewServer(jsonRPC rpc, store gokv.Store) *Server {
+	s := &Server{
+		rpc:               jsonRPC,
+		store:             store,
+		Subsystems:        make(map[string]*pb.NvmeSubsystem),
+		Controllers:       make(map[string]*pb.NvmeController),
+		Namespaces:        make(map[string]*pb.NvmeNamespace),
+		Pagination:        make(map[string]int),
+		statsCache:        make(map[string]nvmeNamespaceStatsSample),
+		RemoteControllers: make(map[string]*pb.NvmeController),
+	}
+	s.restoreNamespaces()
+	return s
+}
+
+// restoreNamespaces loads every NvmeNamespace recorded in the namespace index back into memory
+func (s *Server) restoreNamespaces() {
+	index, err := s.loadNamespaceIndex()
+	if err != nil {
+		log.Printf("error: unable to load namespace index: %v", err)
+		return
+	}
+	for _, name := range index {
+		namespace := &pb.NvmeNamespace{}
+		found, err := s.store.Get(name, namespace)
+		if err != nil {
+			log.Printf("error: unable to load namespace %s: %v", name, err)
+			continue
+		}
+		if !found {
+			continue
+		}
+		s.Namespaces[name] = namespace
+	}
+}
+
+// Reconcile drops cached namespaces whose HostNsid is no longer present on the device, e.g.
+// because SPDK lost state while the bridge was down. It must be called only after Subsystems
+// and Controllers have been populated — calling it beforehand is a no-op, since every restored
+// namespace would be skipped for lack of a known subsystem. This package does not call Reconcile
+// itself: whatever composition root constructs the Server (e.g. a cmd/ main) must invoke it once,
+// after replaying/bootstrapping Subsystems and Controllers, for namespace restore-on-startup to
+// actually drop stale entries.
+func (s *Server) Reconcile() {
+	for name, namespace := range s.Namespaces {
+		subsys, ok := s.Subsystems[namespace.Spec.SubsystemId.Value]
+		if !ok {
+			continue
+		}
+		controller, err := s.resolveController(namespace)
+		if err != nil {
+			log.Printf("error: unable to reconcile namespace %s: %v", name, err)
+			continue
+		}
+		params := models.NvdaControllerNvmeNamespaceListParams{Subnqn: subsys.Spec.Nqn, Cntlid: int(controller.Spec.Cntlid)}
+		var result models.NvdaControllerNvmeNamespaceListResult
+		if err := s.rpc.Call(context.Background(), "controller_nvme_namespace_list", &params, &result); err != nil {
+			log.Printf("error: unable to reconcile namespace %s: %v", name, err)
+			continue
+		}
+		present := false
+		for _, r := range result.Namespaces {
+			if r.Nsid == int(namespace.Spec.HostNsid) {
+				present = true
+				break
+			}
+		}
+		if !present {
+			log.Printf("dropping stale namespace %s: HostNsid %d not found on device", name, namespace.Spec.HostNsid)
+			delete(s.Namespaces, name)
+			s.deleteNamespace(name)
+		}
+	}
+}
+
+// persistNamespace writes namespace to the store and records it in the namespace index
+func (s *Server) persistNamespace(namespace *pb.NvmeNamespace) {
+	if err := s.store.Set(namespace.Name, namespace); err != nil {
+		log.Printf("error: unable to persist namespace %s: %v", namespace.Name, err)
+		return
+	}
+	s.addToNamespaceIndex(namespace.Name)
+}
+
+// deleteNamespace removes namespace from the store and the namespace index
+func (s *Server) deleteNamespace(name string) {
+	if err := s.store.Delete(name); err != nil {
+		log.Printf("error: unable to delete persisted namespace %s: %v", name, err)
+	}
+	s.removeFromNamespaceIndex(name)
+}
+
+func (s *Server) loadNamespaceIndex() ([]string, error) {
+	var index []string
+	found, err := s.store.Get(namespaceIndexKey, &index)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+	return index, nil
+}
+
+func (s *Server) addToNamespaceIndex(name string) {
+	index, err := s.loadNamespaceIndex()
+	if err != nil {
+		log.Printf("error: unable to load namespace index: %v", err)
+		return
+	}
+	for _, existing := range index {
+		if existing == name {
+			return
+		}
+	}
+	index = append(index, name)
+	if err := s.store.Set(namespaceIndexKey, index); err != nil {
+		log.Printf("error: unable to persist namespace index: %v", err)
+	}
+}
+
+func (s *Server) removeFromNamespaceIndex(name string) {
+	index, err := s.loadNamespaceIndex()
+	if err != nil {
+		log.Printf("error: unable to load namespace index: %v", err)
+		return
+	}
+	out := index[:0]
+	for _, existing := range index {
+		if existing != name {
+			out = append(out, existing)
+		}
+	}
+	if err := s.store.Set(namespaceIndexKey, out); err != nil {
+		log.Printf("error: unable to persist namespace index: %v", err)
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"path"
 	"sort"
 	"strconv"
 
@@ -34,8 +33,69 @@ func sortNvmeNamespaces(namespaces []*pb.NvmeNamespace) {
 	})
 }
 
+// resolveController looks up the NvmeController a namespace is attached through,
+// so multi-controller subsystems (multi-host / multi-path configs) get the right Cntlid
+// instead of the hard-coded primary controller.
+func (s *Server) resolveController(namespace *pb.NvmeNamespace) (*pb.NvmeController, error) {
+	if namespace.Spec.ControllerId == nil || namespace.Spec.ControllerId.Value == "" {
+		err := status.Error(codes.FailedPrecondition, "namespace has no controller reference")
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	controller, ok := s.Controllers[namespace.Spec.ControllerId.Value]
+	if !ok {
+		err := status.Errorf(codes.FailedPrecondition, "unable to find controller %s", namespace.Spec.ControllerId.Value)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	return controller, nil
+}
+
+// findControllerForSubsystem returns a controller registered against subsystemID, used by
+// operations that only have a subsystem resource name to work with (e.g. ListNvmeNamespaces).
+// When a subsystem has more than one registered controller (multi-host / multi-path configs),
+// the one with the lowest Cntlid is picked, so repeated calls are deterministic rather than
+// depending on Go's randomized map iteration order.
+func (s *Server) findControllerForSubsystem(subsystemID string) (*pb.NvmeController, error) {
+	var found *pb.NvmeController
+	for _, controller := range s.Controllers {
+		if controller.Spec.SubsystemId.Value != subsystemID {
+			continue
+		}
+		if found == nil || controller.Spec.Cntlid < found.Spec.Cntlid {
+			found = controller
+		}
+	}
+	if found == nil {
+		err := status.Errorf(codes.FailedPrecondition, "unable to find a controller for subsystem %s", subsystemID)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	return found, nil
+}
+
+// nvmeNamespaceStatsSample is the last-observed cumulative iostat counters for a namespace
+type nvmeNamespaceStatsSample struct {
+	readIos           int64
+	writeIos          int64
+	readBytes         int64
+	writeBytes        int64
+	readLatencyTicks  int64
+	writeLatencyTicks int64
+}
+
+// validateNvmeNamespaceSpec checks that namespace has a Spec with a non-empty SubsystemId,
+// returning InvalidArgument otherwise. Spec.VolumeId and Spec.Uuid are not checked, although
+// attachAndStoreNvmeNamespace reads both
+func validateNvmeNamespaceSpec(namespace *pb.NvmeNamespace) error {
+	if namespace.Spec == nil || namespace.Spec.SubsystemId == nil || namespace.Spec.SubsystemId.Value == "" {
+		return status.Error(codes.InvalidArgument, "invalid input subsystem parameters")
+	}
+	return nil
+}
+
 // CreateNvmeNamespace creates an Nvme namespace
-func (s *Server) CreateNvmeNamespace(_ context.Context, in *pb.CreateNvmeNamespaceRequest) (*pb.NvmeNamespace, error) {
+func (s *Server) CreateNvmeNamespace(ctx context.Context, in *pb.CreateNvmeNamespaceRequest) (*pb.NvmeNamespace, error) {
 	log.Printf("CreateNvmeNamespace: Received from client: %v", in)
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
@@ -43,8 +103,8 @@ func (s *Server) CreateNvmeNamespace(_ context.Context, in *pb.CreateNvmeNamespa
 		return nil, err
 	}
 	// check input parameters validity
-	if in.NvmeNamespace.Spec == nil || in.NvmeNamespace.Spec.SubsystemId == nil || in.NvmeNamespace.Spec.SubsystemId.Value == "" {
-		return nil, status.Error(codes.InvalidArgument, "invalid input subsystem parameters")
+	if err := validateNvmeNamespaceSpec(in.NvmeNamespace); err != nil {
+		return nil, err
 	}
 	// see https://google.aip.dev/133#user-specified-ids
 	resourceID := resourceid.NewSystemGenerated()
@@ -65,43 +125,54 @@ func (s *Server) CreateNvmeNamespace(_ context.Context, in *pb.CreateNvmeNamespa
 		return namespace, nil
 	}
 	// not found, so create a new one
-	subsys, ok := s.Subsystems[in.NvmeNamespace.Spec.SubsystemId.Value]
+	return s.attachAndStoreNvmeNamespace(ctx, in.NvmeNamespace)
+}
+
+// attachAndStoreNvmeNamespace issues the SPDK attach RPC for namespace and, on success,
+// stores the resulting object in s.Namespaces keyed by namespace.Name
+func (s *Server) attachAndStoreNvmeNamespace(ctx context.Context, namespace *pb.NvmeNamespace) (*pb.NvmeNamespace, error) {
+	subsys, ok := s.Subsystems[namespace.Spec.SubsystemId.Value]
 	if !ok {
-		err := status.Errorf(codes.NotFound, "unable to find key %s", in.NvmeNamespace.Spec.SubsystemId.Value)
+		err := status.Errorf(codes.NotFound, "unable to find key %s", namespace.Spec.SubsystemId.Value)
 		log.Printf("error: %v", err)
 		return nil, err
 	}
+	controller, err := s.resolveController(namespace)
+	if err != nil {
+		return nil, err
+	}
 	// TODO: do lookup through VolumeId key instead of using it's value
 	params := models.NvdaControllerNvmeNamespaceAttachParams{
 		BdevType: "spdk",
-		Bdev:     in.NvmeNamespace.Spec.VolumeId.Value,
-		Nsid:     int(in.NvmeNamespace.Spec.HostNsid),
+		Bdev:     namespace.Spec.VolumeId.Value,
+		Nsid:     int(namespace.Spec.HostNsid),
 		Subnqn:   subsys.Spec.Nqn,
-		Cntlid:   0,
-		UUID:     in.NvmeNamespace.Spec.Uuid.Value,
-		Nguid:    in.NvmeNamespace.Spec.Nguid,
-		Eui64:    strconv.FormatInt(in.NvmeNamespace.Spec.Eui64, 10),
+		Cntlid:   int(controller.Spec.Cntlid),
+		UUID:     namespace.Spec.Uuid.Value,
+		Nguid:    namespace.Spec.Nguid,
+		Eui64:    strconv.FormatInt(namespace.Spec.Eui64, 10),
 	}
 	var result models.NvdaControllerNvmeNamespaceAttachResult
-	err := s.rpc.Call("controller_nvme_namespace_attach", &params, &result)
+	err = s.rpc.Call(ctx, "controller_nvme_namespace_attach", &params, &result)
 	if err != nil {
 		log.Printf("error: %v", err)
 		return nil, err
 	}
 	log.Printf("Received from SPDK: %v", result)
 	if !result {
-		msg := fmt.Sprintf("Could not create NS: %s", in.NvmeNamespace.Name)
+		msg := fmt.Sprintf("Could not create NS: %s", namespace.Name)
 		log.Print(msg)
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
-	response := server.ProtoClone(in.NvmeNamespace)
+	response := server.ProtoClone(namespace)
 	response.Status = &pb.NvmeNamespaceStatus{PciState: 2, PciOperState: 1}
-	s.Namespaces[in.NvmeNamespace.Name] = response
+	s.Namespaces[namespace.Name] = response
+	s.persistNamespace(response)
 	return response, nil
 }
 
 // DeleteNvmeNamespace deletes an Nvme namespace
-func (s *Server) DeleteNvmeNamespace(_ context.Context, in *pb.DeleteNvmeNamespaceRequest) (*emptypb.Empty, error) {
+func (s *Server) DeleteNvmeNamespace(ctx context.Context, in *pb.DeleteNvmeNamespaceRequest) (*emptypb.Empty, error) {
 	log.Printf("DeleteNvmeNamespace: Received from client: %v", in)
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
@@ -129,15 +200,18 @@ func (s *Server) DeleteNvmeNamespace(_ context.Context, in *pb.DeleteNvmeNamespa
 		log.Printf("error: %v", err)
 		return nil, err
 	}
+	controller, err := s.resolveController(namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	// TODO: fix hard-coded Cntlid
 	params := models.NvdaControllerNvmeNamespaceDetachParams{
 		Nsid:   int(namespace.Spec.HostNsid),
 		Subnqn: subsys.Spec.Nqn,
-		Cntlid: 0,
+		Cntlid: int(controller.Spec.Cntlid),
 	}
 	var result models.NvdaControllerNvmeNamespaceDetachResult
-	err := s.rpc.Call("controller_nvme_namespace_detach", &params, &result)
+	err = s.rpc.Call(ctx, "controller_nvme_namespace_detach", &params, &result)
 	if err != nil {
 		log.Printf("error: %v", err)
 		return nil, err
@@ -149,11 +223,12 @@ func (s *Server) DeleteNvmeNamespace(_ context.Context, in *pb.DeleteNvmeNamespa
 		return nil, status.Errorf(codes.InvalidArgument, msg)
 	}
 	delete(s.Namespaces, namespace.Name)
+	s.deleteNamespace(namespace.Name)
 	return &emptypb.Empty{}, nil
 }
 
 // UpdateNvmeNamespace updates an Nvme namespace
-func (s *Server) UpdateNvmeNamespace(_ context.Context, in *pb.UpdateNvmeNamespaceRequest) (*pb.NvmeNamespace, error) {
+func (s *Server) UpdateNvmeNamespace(ctx context.Context, in *pb.UpdateNvmeNamespaceRequest) (*pb.NvmeNamespace, error) {
 	log.Printf("UpdateNvmeNamespace: Received from client: %v", in)
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
@@ -165,28 +240,134 @@ func (s *Server) UpdateNvmeNamespace(_ context.Context, in *pb.UpdateNvmeNamespa
 		log.Printf("error: %v", err)
 		return nil, err
 	}
+	// update_mask = 2
+	if err := fieldmask.Validate(in.UpdateMask, in.NvmeNamespace); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
 	// fetch object from the database
 	volume, ok := s.Namespaces[in.NvmeNamespace.Name]
 	if !ok {
-		if in.AllowMissing {
-			log.Printf("TODO: in case of AllowMissing, create a new resource, don;t return error")
+		if !in.AllowMissing {
+			err := status.Errorf(codes.NotFound, "unable to find key %s", in.NvmeNamespace.Name)
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+		if err := validateNvmeNamespaceSpec(in.NvmeNamespace); err != nil {
+			return nil, err
 		}
-		err := status.Errorf(codes.NotFound, "unable to find key %s", in.NvmeNamespace.Name)
+		log.Printf("AllowMissing is set, attaching a new NvmeNamespace with id %v", in.NvmeNamespace.Name)
+		return s.attachAndStoreNvmeNamespace(ctx, in.NvmeNamespace)
+	}
+
+	updated := server.ProtoClone(volume)
+	mutated := false
+	for _, fieldPath := range in.UpdateMask.GetPaths() {
+		switch fieldPath {
+		case "spec.volume_id":
+			updated.Spec.VolumeId = in.NvmeNamespace.Spec.VolumeId
+			mutated = true
+		case "spec.host_nsid":
+			updated.Spec.HostNsid = in.NvmeNamespace.Spec.HostNsid
+			mutated = true
+		case "spec.uuid":
+			updated.Spec.Uuid = in.NvmeNamespace.Spec.Uuid
+			mutated = true
+		case "spec.nguid":
+			updated.Spec.Nguid = in.NvmeNamespace.Spec.Nguid
+			mutated = true
+		case "spec.eui64":
+			updated.Spec.Eui64 = in.NvmeNamespace.Spec.Eui64
+			mutated = true
+		}
+	}
+	if !mutated {
+		// nothing mutable was requested, return the stored object unchanged
+		return volume, nil
+	}
+
+	subsys, ok := s.Subsystems[volume.Spec.SubsystemId.Value]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", volume.Spec.SubsystemId.Value)
 		log.Printf("error: %v", err)
 		return nil, err
 	}
-	resourceID := path.Base(volume.Name)
-	// update_mask = 2
-	if err := fieldmask.Validate(in.UpdateMask, in.NvmeNamespace); err != nil {
+	controller, err := s.resolveController(volume)
+	if err != nil {
+		return nil, err
+	}
+	detachParams := models.NvdaControllerNvmeNamespaceDetachParams{
+		Nsid:   int(volume.Spec.HostNsid),
+		Subnqn: subsys.Spec.Nqn,
+		Cntlid: int(controller.Spec.Cntlid),
+	}
+	var detachResult models.NvdaControllerNvmeNamespaceDetachResult
+	if err := s.rpc.Call(ctx, "controller_nvme_namespace_detach", &detachParams, &detachResult); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	if !detachResult {
+		msg := fmt.Sprintf("Could not detach NS: %s", volume.Name)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+
+	attachParams := models.NvdaControllerNvmeNamespaceAttachParams{
+		BdevType: "spdk",
+		Bdev:     updated.Spec.VolumeId.Value,
+		Nsid:     int(updated.Spec.HostNsid),
+		Subnqn:   subsys.Spec.Nqn,
+		Cntlid:   int(controller.Spec.Cntlid),
+		UUID:     updated.Spec.Uuid.Value,
+		Nguid:    updated.Spec.Nguid,
+		Eui64:    strconv.FormatInt(updated.Spec.Eui64, 10),
+	}
+	var attachResult models.NvdaControllerNvmeNamespaceAttachResult
+	if err := s.rpc.Call(ctx, "controller_nvme_namespace_attach", &attachParams, &attachResult); err != nil {
 		log.Printf("error: %v", err)
+		s.rollbackNamespaceAttach(ctx, volume, subsys, controller)
 		return nil, err
 	}
-	log.Printf("TODO: use resourceID=%v", resourceID)
-	return nil, status.Errorf(codes.Unimplemented, "UpdateNvmeNamespace method is not implemented")
+	if !attachResult {
+		msg := fmt.Sprintf("Could not re-attach NS: %s", updated.Name)
+		log.Print(msg)
+		s.rollbackNamespaceAttach(ctx, volume, subsys, controller)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+
+	s.Namespaces[updated.Name] = updated
+	s.persistNamespace(updated)
+	return updated, nil
+}
+
+// rollbackNamespaceAttach re-issues controller_nvme_namespace_attach with namespace's original
+// (pre-update) params after a failed re-attach in UpdateNvmeNamespace. The preceding detach has
+// already succeeded by this point, so without a compensating attach the device is left physically
+// detached while s.Namespaces still reports the old config as attached — exactly the torn state
+// the detach/re-attach dance is meant to avoid. If the compensating attach also fails, the device
+// needs manual reattachment; that failure is logged distinctly so it isn't mistaken for the
+// original re-attach error.
+func (s *Server) rollbackNamespaceAttach(ctx context.Context, namespace *pb.NvmeNamespace, subsys *pb.NvmeSubsystem, controller *pb.NvmeController) {
+	params := models.NvdaControllerNvmeNamespaceAttachParams{
+		BdevType: "spdk",
+		Bdev:     namespace.Spec.VolumeId.Value,
+		Nsid:     int(namespace.Spec.HostNsid),
+		Subnqn:   subsys.Spec.Nqn,
+		Cntlid:   int(controller.Spec.Cntlid),
+		UUID:     namespace.Spec.Uuid.Value,
+		Nguid:    namespace.Spec.Nguid,
+		Eui64:    strconv.FormatInt(namespace.Spec.Eui64, 10),
+	}
+	var result models.NvdaControllerNvmeNamespaceAttachResult
+	if err := s.rpc.Call(ctx, "controller_nvme_namespace_attach", &params, &result); err != nil || !result {
+		log.Printf("error: failed to roll back namespace %s to its pre-update attach state after a failed re-attach; SPDK now has it detached while it is still cached as attached, manual reattachment required: %v", namespace.Name, err)
+		return
+	}
+	s.Namespaces[namespace.Name] = namespace
 }
 
 // ListNvmeNamespaces lists Nvme namespaces
-func (s *Server) ListNvmeNamespaces(_ context.Context, in *pb.ListNvmeNamespacesRequest) (*pb.ListNvmeNamespacesResponse, error) {
+func (s *Server) ListNvmeNamespaces(ctx context.Context, in *pb.ListNvmeNamespacesRequest) (*pb.ListNvmeNamespacesResponse, error) {
 	log.Printf("ListNvmeNamespaces: Received from client: %v", in)
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
@@ -205,13 +386,16 @@ func (s *Server) ListNvmeNamespaces(_ context.Context, in *pb.ListNvmeNamespaces
 		log.Printf("error: %v", err)
 		return nil, err
 	}
-	// TODO: fix hard-coded Cntlid
+	controller, err := s.findControllerForSubsystem(in.Parent)
+	if err != nil {
+		return nil, err
+	}
 	params := models.NvdaControllerNvmeNamespaceListParams{
 		Subnqn: subsys.Spec.Nqn,
-		Cntlid: 0,
+		Cntlid: int(controller.Spec.Cntlid),
 	}
 	var result models.NvdaControllerNvmeNamespaceListResult
-	err := s.rpc.Call("controller_nvme_namespace_list", &params, &result)
+	err = s.rpc.Call(ctx, "controller_nvme_namespace_list", &params, &result)
 	if err != nil {
 		log.Printf("error: %v", err)
 		return nil, err
@@ -234,7 +418,7 @@ func (s *Server) ListNvmeNamespaces(_ context.Context, in *pb.ListNvmeNamespaces
 }
 
 // GetNvmeNamespace gets an Nvme namespace
-func (s *Server) GetNvmeNamespace(_ context.Context, in *pb.GetNvmeNamespaceRequest) (*pb.NvmeNamespace, error) {
+func (s *Server) GetNvmeNamespace(ctx context.Context, in *pb.GetNvmeNamespaceRequest) (*pb.NvmeNamespace, error) {
 	log.Printf("GetNvmeNamespace: Received from client: %v", in)
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
@@ -259,13 +443,16 @@ func (s *Server) GetNvmeNamespace(_ context.Context, in *pb.GetNvmeNamespaceRequ
 		log.Printf("error: %v", err)
 		return nil, err
 	}
-	// TODO: fix hard-coded Cntlid
+	controller, err := s.resolveController(namespace)
+	if err != nil {
+		return nil, err
+	}
 	params := models.NvdaControllerNvmeNamespaceListParams{
 		Subnqn: subsys.Spec.Nqn,
-		Cntlid: 0,
+		Cntlid: int(controller.Spec.Cntlid),
 	}
 	var result models.NvdaControllerNvmeNamespaceListResult
-	err := s.rpc.Call("controller_nvme_namespace_list", &params, &result)
+	err = s.rpc.Call(ctx, "controller_nvme_namespace_list", &params, &result)
 	if err != nil {
 		log.Printf("error: %v", err)
 		return nil, err
@@ -283,7 +470,7 @@ func (s *Server) GetNvmeNamespace(_ context.Context, in *pb.GetNvmeNamespaceRequ
 }
 
 // NvmeNamespaceStats gets an Nvme namespace stats
-func (s *Server) NvmeNamespaceStats(_ context.Context, in *pb.NvmeNamespaceStatsRequest) (*pb.NvmeNamespaceStatsResponse, error) {
+func (s *Server) NvmeNamespaceStats(ctx context.Context, in *pb.NvmeNamespaceStatsRequest) (*pb.NvmeNamespaceStatsResponse, error) {
 	log.Printf("NvmeNamespaceStats: Received from client: %v", in)
 	// check required fields
 	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
@@ -303,23 +490,72 @@ func (s *Server) NvmeNamespaceStats(_ context.Context, in *pb.NvmeNamespaceStats
 		return nil, err
 	}
 	var result models.NvdaControllerNvmeStatsResult
-	err := s.rpc.Call("controller_nvme_get_iostat", nil, &result)
+	err := s.rpc.Call(ctx, "controller_nvme_get_iostat", nil, &result)
 	if err != nil {
 		log.Printf("error: %v", err)
 		return nil, err
 	}
 	log.Printf("Received from SPDK: %v", result)
+	found := false
+	var readIos, writeIos, readBytes, writeBytes, readTicks, writeTicks, ioOutstanding int64
 	for _, c := range result.Controllers {
 		for _, r := range c.Bdevs {
 			if r.BdevName == namespace.Spec.VolumeId.Value {
-				return &pb.NvmeNamespaceStatsResponse{Id: in.NamespaceId, Stats: &pb.VolumeStats{
-					ReadOpsCount:  int32(r.ReadIos),
-					WriteOpsCount: int32(r.WriteIos),
-				}}, nil
+				found = true
+				readIos += r.ReadIos
+				writeIos += r.WriteIos
+				readBytes += r.ReadBytes
+				writeBytes += r.WriteBytes
+				readTicks += r.ReadLatencyTicks
+				writeTicks += r.WriteLatencyTicks
+				ioOutstanding += r.IoOutstanding
 			}
 		}
 	}
-	msg := fmt.Sprintf("Could not find BdevName: %s", namespace.Spec.VolumeId.Value)
-	log.Print(msg)
-	return nil, status.Errorf(codes.InvalidArgument, msg)
-}
\ No newline at end of file
+	if !found {
+		msg := fmt.Sprintf("Could not find BdevName: %s", namespace.Spec.VolumeId.Value)
+		log.Print(msg)
+		return nil, status.Errorf(codes.InvalidArgument, msg)
+	}
+
+	stats := &pb.VolumeStats{
+		ReadOpsCount:       int32(readIos),
+		WriteOpsCount:      int32(writeIos),
+		ReadBytesCount:     readBytes,
+		WriteBytesCount:    writeBytes,
+		ReadLatencyTicks:   readTicks,
+		WriteLatencyTicks:  writeTicks,
+		IoOutstandingCount: ioOutstanding,
+		TickRate:           result.TickRate,
+	}
+	// report per-interval deltas when we have a prior sample to diff against, so the
+	// client gets IOPS/BW-able numbers without having to track cumulative counters itself.
+	// IoOutstandingCount and TickRate are instantaneous/constant, not cumulative counters,
+	// so they are left out of the delta and always reflect the latest reading.
+	if prev, ok := s.statsCache[in.NamespaceId.Value]; ok {
+		if readIos < prev.readIos || writeIos < prev.writeIos || readBytes < prev.readBytes ||
+			writeBytes < prev.writeBytes || readTicks < prev.readLatencyTicks || writeTicks < prev.writeLatencyTicks {
+			// a counter went backwards, e.g. the bdev/controller was recreated or a SPDK-side
+			// counter rolled over; diffing against the stale prev sample would emit negative
+			// IOPS/bandwidth, so fall back to the raw cumulative counters for this interval
+			log.Printf("namespace %s: iostat counters reset since the last sample, reporting cumulative values", in.NamespaceId.Value)
+		} else {
+			stats.ReadOpsCount = int32(readIos - prev.readIos)
+			stats.WriteOpsCount = int32(writeIos - prev.writeIos)
+			stats.ReadBytesCount = readBytes - prev.readBytes
+			stats.WriteBytesCount = writeBytes - prev.writeBytes
+			stats.ReadLatencyTicks = readTicks - prev.readLatencyTicks
+			stats.WriteLatencyTicks = writeTicks - prev.writeLatencyTicks
+		}
+	}
+	s.statsCache[in.NamespaceId.Value] = nvmeNamespaceStatsSample{
+		readIos:           readIos,
+		writeIos:          writeIos,
+		readBytes:         readBytes,
+		writeBytes:        writeBytes,
+		readLatencyTicks:  readTicks,
+		writeLatencyTicks: writeTicks,
+	}
+
+	return &pb.NvmeNamespaceStatsResponse{Id: in.NamespaceId, Stats: stats}, nil
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+// Copyright (c) 2022 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+// Copyright (C) 2023 Intel Corporation
+
+package frontend
+
+import (
+	"testing"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestResolveController(t *testing.T) {
+	controller := &pb.NvmeController{Spec: &pb.NvmeControllerSpec{Cntlid: 7}}
+	s := &Server{Controllers: map[string]*pb.NvmeController{"controller-1": controller}}
+
+	tests := map[string]struct {
+		namespace *pb.NvmeNamespace
+		wantErr   bool
+	}{
+		"no controller reference": {
+			namespace: &pb.NvmeNamespace{Spec: &pb.NvmeNamespaceSpec{}},
+			wantErr:   true,
+		},
+		"unknown controller reference": {
+			namespace: &pb.NvmeNamespace{Spec: &pb.NvmeNamespaceSpec{ControllerId: wrapperspb.String("controller-missing")}},
+			wantErr:   true,
+		},
+		"known controller reference": {
+			namespace: &pb.NvmeNamespace{Spec: &pb.NvmeNamespaceSpec{ControllerId: wrapperspb.String("controller-1")}},
+			wantErr:   false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := s.resolveController(tt.namespace)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveController() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != controller {
+				t.Fatalf("resolveController() = %v, want %v", got, controller)
+			}
+		})
+	}
+}
+
+func TestFindControllerForSubsystemPicksLowestCntlid(t *testing.T) {
+	lowest := &pb.NvmeController{Spec: &pb.NvmeControllerSpec{SubsystemId: wrapperspb.String("subsys-1"), Cntlid: 1}}
+	higher := &pb.NvmeController{Spec: &pb.NvmeControllerSpec{SubsystemId: wrapperspb.String("subsys-1"), Cntlid: 5}}
+	other := &pb.NvmeController{Spec: &pb.NvmeControllerSpec{SubsystemId: wrapperspb.String("subsys-2"), Cntlid: 0}}
+	s := &Server{Controllers: map[string]*pb.NvmeController{
+		"controller-higher": higher,
+		"controller-lowest": lowest,
+		"controller-other":  other,
+	}}
+
+	// run several times since map iteration order is randomized per run and the bug this
+	// guards against only showed up nondeterministically
+	for i := 0; i < 10; i++ {
+		got, err := s.findControllerForSubsystem("subsys-1")
+		if err != nil {
+			t.Fatalf("findControllerForSubsystem() error = %v", err)
+		}
+		if got != lowest {
+			t.Fatalf("findControllerForSubsystem() = %v, want the lowest-Cntlid controller %v", got, lowest)
+		}
+	}
+}
+
+func TestFindControllerForSubsystemNotFound(t *testing.T) {
+	s := &Server{Controllers: map[string]*pb.NvmeController{}}
+	if _, err := s.findControllerForSubsystem("subsys-missing"); err == nil {
+		t.Fatal("findControllerForSubsystem() error = nil, want an error for an unregistered subsystem")
+	}
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+// Copyright (c) 2022 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+// Copyright (C) 2023 Intel Corporation
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-nvidia-bridge/pkg/models"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// fakeRPC is a minimal rpc implementation that lets tests script per-method
+// results/errors without talking to a real SPDK target, while recording every
+// method called so tests can assert on the RPCs actually issued
+type fakeRPC struct {
+	errs  map[string]error
+	calls []string
+}
+
+func (f *fakeRPC) Call(_ context.Context, method string, _, result any) error {
+	f.calls = append(f.calls, method)
+	if err, ok := f.errs[method]; ok && err != nil {
+		return err
+	}
+	switch r := result.(type) {
+	case *models.NvdaControllerNvmeNamespaceDetachResult:
+		*r = true
+	case *models.NvdaControllerNvmeNamespaceAttachResult:
+		*r = true
+	}
+	return nil
+}
+
+func (f *fakeRPC) callCount(method string) int {
+	n := 0
+	for _, m := range f.calls {
+		if m == method {
+			n++
+		}
+	}
+	return n
+}
+
+// fakeStore is a minimal gokv.Store that keeps everything in memory, enough
+// for persistNamespace/deleteNamespace to have somewhere to write
+type fakeStore struct {
+	values map[string]any
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{values: make(map[string]any)}
+}
+
+func (f *fakeStore) Set(k string, v any) error {
+	f.values[k] = v
+	return nil
+}
+
+func (f *fakeStore) Get(k string, v any) (bool, error) {
+	stored, ok := f.values[k]
+	if !ok {
+		return false, nil
+	}
+	switch dst := v.(type) {
+	case *pb.NvmeNamespace:
+		*dst = *stored.(*pb.NvmeNamespace)
+	case *[]string:
+		*dst = stored.([]string)
+	}
+	return true, nil
+}
+
+func (f *fakeStore) Delete(k string) error {
+	delete(f.values, k)
+	return nil
+}
+
+func (f *fakeStore) Close() error { return nil }
+
+func newTestServerForUpdate(t *testing.T, rpcErrs map[string]error) (*Server, *fakeRPC, *pb.NvmeNamespace) {
+	t.Helper()
+	volume := &pb.NvmeNamespace{
+		Name: "namespace-1",
+		Spec: &pb.NvmeNamespaceSpec{
+			SubsystemId:  wrapperspb.String("subsystem-1"),
+			ControllerId: wrapperspb.String("controller-1"),
+			VolumeId:     wrapperspb.String("volume-1"),
+			Uuid:         wrapperspb.String("uuid-1"),
+			HostNsid:     1,
+		},
+	}
+	rpc := &fakeRPC{errs: rpcErrs}
+	s := &Server{
+		rpc:         rpc,
+		store:       newFakeStore(),
+		Subsystems:  map[string]*pb.NvmeSubsystem{"subsystem-1": {Spec: &pb.NvmeSubsystemSpec{Nqn: "nqn.test"}}},
+		Controllers: map[string]*pb.NvmeController{"controller-1": {Spec: &pb.NvmeControllerSpec{Cntlid: 0}}},
+		Namespaces:  map[string]*pb.NvmeNamespace{"namespace-1": volume},
+		Pagination:  make(map[string]int),
+	}
+	return s, rpc, volume
+}
+
+func TestUpdateNvmeNamespaceRollsBackOnAttachFailure(t *testing.T) {
+	s, rpc, original := newTestServerForUpdate(t, map[string]error{
+		"controller_nvme_namespace_attach": errors.New("attach failed"),
+	})
+	req := &pb.UpdateNvmeNamespaceRequest{
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"spec.nguid"}},
+		NvmeNamespace: &pb.NvmeNamespace{
+			Name: "namespace-1",
+			Spec: &pb.NvmeNamespaceSpec{Nguid: "new-nguid"},
+		},
+	}
+
+	if _, err := s.UpdateNvmeNamespace(context.Background(), req); err == nil {
+		t.Fatal("UpdateNvmeNamespace() error = nil, want an error when re-attach fails")
+	}
+
+	got := s.Namespaces["namespace-1"]
+	if got != original {
+		t.Fatalf("UpdateNvmeNamespace() left Namespaces[%q] = %v, want rollback to the original %v", "namespace-1", got, original)
+	}
+	if got.Spec.Nguid != "" {
+		t.Fatalf("UpdateNvmeNamespace() rolled-back namespace has Nguid %q, want the pre-update value", got.Spec.Nguid)
+	}
+	// the failed re-attach must be followed by a compensating attach carrying the original
+	// params, not just a map-only revert, or the device is left detached while cached as attached
+	if got := rpc.callCount("controller_nvme_namespace_attach"); got != 2 {
+		t.Fatalf("controller_nvme_namespace_attach called %d times, want 2 (the failed re-attach plus a compensating rollback attach)", got)
+	}
+}
+
+func TestUpdateNvmeNamespaceAppliesMutationOnSuccess(t *testing.T) {
+	s, _, _ := newTestServerForUpdate(t, nil)
+	req := &pb.UpdateNvmeNamespaceRequest{
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"spec.nguid"}},
+		NvmeNamespace: &pb.NvmeNamespace{
+			Name: "namespace-1",
+			Spec: &pb.NvmeNamespaceSpec{Nguid: "new-nguid"},
+		},
+	}
+
+	got, err := s.UpdateNvmeNamespace(context.Background(), req)
+	if err != nil {
+		t.Fatalf("UpdateNvmeNamespace() error = %v", err)
+	}
+	if got.Spec.Nguid != "new-nguid" {
+		t.Fatalf("UpdateNvmeNamespace() Nguid = %q, want %q", got.Spec.Nguid, "new-nguid")
+	}
+	if s.Namespaces["namespace-1"] != got {
+		t.Fatal("UpdateNvmeNamespace() did not store the updated namespace in Namespaces")
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+// Copyright (c) 2022 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+// Copyright (C) 2023 Intel Corporation
+
+// Package frontend implememnts the FrontEnd APIs (host facing) of the storage Server
+package frontend
+
+import (
+	"context"
+	"log"
+	"path"
+	"sort"
+
+	pb "github.com/opiproject/opi-api/storage/v1alpha1/gen/go"
+	"github.com/opiproject/opi-nvidia-bridge/pkg/models"
+	"github.com/opiproject/opi-spdk-bridge/pkg/server"
+
+	"github.com/google/uuid"
+	"go.einride.tech/aip/fieldbehavior"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func sortNvmeRemoteNamespaces(namespaces []*pb.NvmeRemoteNamespace) {
+	sort.Slice(namespaces, func(i int, j int) bool {
+		return namespaces[i].Nsid < namespaces[j].Nsid
+	})
+}
+
+// ListRemoteNvmeNamespaces discovers the namespaces exposed by a connected remote NVMe-oF
+// controller, so orchestrators can see what's reachable on the SmartNIC without provisioning
+// namespaces first
+func (s *Server) ListRemoteNvmeNamespaces(ctx context.Context, in *pb.ListRemoteNvmeNamespacesRequest) (*pb.ListRemoteNvmeNamespacesResponse, error) {
+	log.Printf("ListRemoteNvmeNamespaces: Received from client: %v", in)
+	// check required fields
+	if err := fieldbehavior.ValidateRequiredFields(in); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	// fetch object from the database
+	size, offset, perr := server.ExtractPagination(in.PageSize, in.PageToken, s.Pagination)
+	if perr != nil {
+		log.Printf("error: %v", perr)
+		return nil, perr
+	}
+	controller, ok := s.RemoteControllers[in.Parent]
+	if !ok {
+		err := status.Errorf(codes.NotFound, "unable to find key %s", in.Parent)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+
+	var bdevControllers models.BdevNvmeGetControllersResult
+	// the bdev-layer name SPDK assigned when this bridge attached the remote controller
+	// via bdev_nvme_attach_controller is the controller's own resource ID
+	bdevParams := models.BdevNvmeGetControllersParams{Name: path.Base(controller.Name)}
+	if err := s.rpc.Call(ctx, "bdev_nvme_get_controllers", &bdevParams, &bdevControllers); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	log.Printf("Received from SPDK: %v", bdevControllers)
+	if len(bdevControllers) == 0 {
+		err := status.Errorf(codes.NotFound, "no connected NVMe-oF controller found for %s", in.Parent)
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+
+	var namespaces []*pb.NvmeRemoteNamespace
+	for _, bdevController := range bdevControllers {
+		nsParams := models.NvdaBdevNvmeNamespaceListParams{Name: bdevController.Name}
+		var nsResult models.NvdaBdevNvmeNamespaceListResult
+		if err := s.rpc.Call(ctx, "bdev_nvme_get_namespaces", &nsParams, &nsResult); err != nil {
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+		for _, n := range nsResult.Namespaces {
+			namespaces = append(namespaces, &pb.NvmeRemoteNamespace{
+				Nsid:  int32(n.Nsid),
+				Uuid:  n.UUID,
+				Nguid: n.Nguid,
+			})
+		}
+	}
+	sortNvmeRemoteNamespaces(namespaces)
+
+	token, hasMoreElements := "", false
+	log.Printf("Limiting result len(%d) to [%d:%d]", len(namespaces), offset, size)
+	namespaces, hasMoreElements = server.LimitPagination(namespaces, offset, size)
+	if hasMoreElements {
+		token = uuid.New().String()
+		s.Pagination[token] = offset + size
+	}
+	return &pb.ListRemoteNvmeNamespacesResponse{NvmeRemoteNamespaces: namespaces, NextPageToken: token}, nil
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright (c) 2022-2023 Dell Inc, or its subsidiaries.
+// Copyright (c) 2022-2023 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+// Package models contains the request/response payloads exchanged with the
+// NVIDIA SPDK JSON-RPC plugin
+package models
+
+// NvdaControllerNvmeNamespaceAttachParams are the parameters for controller_nvme_namespace_attach
+type NvdaControllerNvmeNamespaceAttachParams struct {
+	BdevType string `json:"bdev_type"`
+	Bdev     string `json:"bdev"`
+	Nsid     int    `json:"nsid"`
+	Subnqn   string `json:"subnqn"`
+	Cntlid   int    `json:"cntlid"`
+	UUID     string `json:"uuid"`
+	Nguid    string `json:"nguid"`
+	Eui64    string `json:"eui64"`
+}
+
+// NvdaControllerNvmeNamespaceAttachResult is the result of controller_nvme_namespace_attach
+type NvdaControllerNvmeNamespaceAttachResult bool
+
+// NvdaControllerNvmeNamespaceDetachParams are the parameters for controller_nvme_namespace_detach
+type NvdaControllerNvmeNamespaceDetachParams struct {
+	Nsid   int    `json:"nsid"`
+	Subnqn string `json:"subnqn"`
+	Cntlid int    `json:"cntlid"`
+}
+
+// NvdaControllerNvmeNamespaceDetachResult is the result of controller_nvme_namespace_detach
+type NvdaControllerNvmeNamespaceDetachResult bool
+
+// NvdaControllerNvmeNamespace is a single namespace entry returned by controller_nvme_namespace_list
+type NvdaControllerNvmeNamespace struct {
+	Nsid     int    `json:"nsid"`
+	BdevName string `json:"bdev_name"`
+	UUID     string `json:"uuid"`
+	Nguid    string `json:"nguid"`
+}
+
+// NvdaControllerNvmeNamespaceListParams are the parameters for controller_nvme_namespace_list
+type NvdaControllerNvmeNamespaceListParams struct {
+	Subnqn string `json:"subnqn"`
+	Cntlid int    `json:"cntlid"`
+}
+
+// NvdaControllerNvmeNamespaceListResult is the result of controller_nvme_namespace_list
+type NvdaControllerNvmeNamespaceListResult struct {
+	Namespaces []NvdaControllerNvmeNamespace `json:"namespaces"`
+}
+
+// NvdaControllerNvmeBdevStats carries the per-bdev iostat counters nested under a controller
+type NvdaControllerNvmeBdevStats struct {
+	BdevName          string `json:"bdev_name"`
+	ReadIos           int64  `json:"read_ios"`
+	WriteIos          int64  `json:"write_ios"`
+	ReadBytes         int64  `json:"read_bytes"`
+	WriteBytes        int64  `json:"write_bytes"`
+	ReadLatencyTicks  int64  `json:"read_latency_ticks"`
+	WriteLatencyTicks int64  `json:"write_latency_ticks"`
+	IoOutstanding     int64  `json:"io_outstanding"`
+}
+
+// NvdaControllerNvmeStats carries the per-controller iostat counters returned by controller_nvme_get_iostat
+type NvdaControllerNvmeStats struct {
+	Cntlid int                           `json:"cntlid"`
+	Bdevs  []NvdaControllerNvmeBdevStats `json:"bdevs"`
+}
+
+// NvdaControllerNvmeStatsResult is the result of controller_nvme_get_iostat
+type NvdaControllerNvmeStatsResult struct {
+	TickRate    int64                     `json:"tick_rate"`
+	Controllers []NvdaControllerNvmeStats `json:"controllers"`
+}
+
+// BdevNvmeGetControllersParams are the parameters for bdev_nvme_get_controllers
+type BdevNvmeGetControllersParams struct {
+	Name string `json:"name,omitempty"`
+}
+
+// BdevNvmeControllerInfo describes a single bdev-layer handle for a connected NVMe-oF controller
+type BdevNvmeControllerInfo struct {
+	Name string `json:"name"`
+}
+
+// BdevNvmeGetControllersResult is the result of bdev_nvme_get_controllers
+type BdevNvmeGetControllersResult []BdevNvmeControllerInfo
+
+// NvdaBdevNvmeNamespaceListParams are the parameters for the per-controller namespace
+// enumeration issued against a connected NVMe-oF controller
+type NvdaBdevNvmeNamespaceListParams struct {
+	Name string `json:"name"`
+}
+
+// NvdaBdevNvmeNamespace is a single namespace entry exposed by a remote NVMe-oF controller
+type NvdaBdevNvmeNamespace struct {
+	Nsid  int    `json:"nsid"`
+	UUID  string `json:"uuid"`
+	Nguid string `json:"nguid"`
+}
+
+// NvdaBdevNvmeNamespaceListResult is the result of the per-controller namespace enumeration RPC
+type NvdaBdevNvmeNamespaceListResult struct {
+	Namespaces []NvdaBdevNvmeNamespace `json:"namespaces"`
+}